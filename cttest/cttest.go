@@ -0,0 +1,102 @@
+package cttest
+
+import (
+	"math"
+	"testing"
+)
+
+// MinIterations is the smallest measurement count Assert and Statistic
+// will run, matching the Reparaz–Balasch–Verbauwhede guidance that fewer
+// samples make the t-test too noisy to trust.
+const MinIterations = 100_000
+
+// LeakThreshold is the |t| value above which the two input classes are
+// considered distinguishable. 4.5 is the standard dudect threshold: under
+// the null hypothesis (no leak) a Welch's t-statistic this extreme has a
+// false-positive rate far below what's worth chasing in CI.
+const LeakThreshold = 4.5
+
+// Assert fails t if f's running time depends on whether it is given
+// fixedInput on every call versus a freshly randomized input of the same
+// length on every call. It runs at least iterations calls of f per class
+// (raised to MinIterations if a smaller value is passed), measuring each
+// call with a cycle counter, discarding each class's slowest 1% of
+// samples as OS-scheduling noise, and failing if the resulting Welch's
+// t-statistic exceeds LeakThreshold in magnitude.
+//
+// f must not mutate the slice it's given, and must treat it as secret:
+// any data-dependent branch or division inside f is exactly what this
+// test is built to catch.
+func Assert(t *testing.T, f func([]byte), fixedInput []byte, iterations int) {
+	t.Helper()
+
+	stat := Statistic(f, fixedInput, iterations)
+	if math.Abs(stat) > LeakThreshold {
+		t.Fatalf("cttest: timing distinguishable between fixed and random input classes over %d iterations: |t| = %.2f (threshold %.2f)", max(iterations, MinIterations), math.Abs(stat), LeakThreshold)
+	}
+}
+
+// Statistic runs the measure/crop/Welch's-t pipeline Assert describes and
+// returns the resulting t-statistic, without applying Assert's threshold,
+// so callers that need a custom pass/fail policy (for example, requiring
+// a leak to reproduce across several independent trials before failing,
+// to ride out measurement noise on a shared or virtualized CI machine)
+// can build on it directly.
+func Statistic(f func([]byte), fixedInput []byte, iterations int) float64 {
+	if iterations < MinIterations {
+		iterations = MinIterations
+	}
+	fixed := make([]uint64, 0, iterations)
+	random := make([]uint64, 0, iterations)
+
+	seed := uint32(0xA5A5A5A5)
+	nextByte := func() byte {
+		seed = seed*1664525 + 1013904223
+		return byte(seed >> 24)
+	}
+
+	randomInput := make([]byte, len(fixedInput))
+	for i := 0; i < iterations; i++ {
+		for j := range randomInput {
+			randomInput[j] = nextByte()
+		}
+
+		// Alternate which class runs first each round so systematic
+		// drift (thermal throttling, frequency scaling) over the run
+		// doesn't bias one class's measurements more than the other's.
+		if nextByte()&1 == 0 {
+			fixed = append(fixed, timeCall(f, fixedInput))
+			random = append(random, timeCall(f, randomInput))
+		} else {
+			random = append(random, timeCall(f, randomInput))
+			fixed = append(fixed, timeCall(f, fixedInput))
+		}
+	}
+
+	fixed = cropOutliers(fixed)
+	random = cropOutliers(random)
+
+	var fixedStats, randomStats welford
+	for _, v := range fixed {
+		fixedStats.add(float64(v))
+	}
+	for _, v := range random {
+		randomStats.add(float64(v))
+	}
+
+	return welchT(&fixedStats, &randomStats)
+}
+
+func timeCall(f func([]byte), input []byte) uint64 {
+	start := cycles()
+	f(input)
+	return cycles() - start
+}
+
+func welchT(a, b *welford) float64 {
+	se := math.Sqrt(a.variance()/float64(a.n) + b.variance()/float64(b.n))
+	if se == 0 {
+		return 0
+	}
+	return (a.mean - b.mean) / se
+}
@@ -0,0 +1,75 @@
+package cttest
+
+import (
+	"math"
+	"testing"
+)
+
+// The leak-detection test exercises Statistic directly, rather than
+// Assert, so a deliberately-leaking function can be confirmed to trip the
+// threshold without that expected failure being reported as this
+// package's own test failing.
+
+func TestStatisticDetectsDataDependentBranch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+
+	leaky := func(b []byte) {
+		if b[0]&1 == 1 {
+			busyWork(4000)
+		} else {
+			busyWork(20)
+		}
+	}
+
+	stat := Statistic(leaky, []byte{0}, 50_000)
+	if math.Abs(stat) <= LeakThreshold {
+		t.Fatalf("expected a data-dependent branch to be flagged, got |t| = %.2f", math.Abs(stat))
+	}
+}
+
+func TestStatisticPassesConstantWork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	if !Strict() {
+		t.Skip("set DOPPLER_CTTEST_STRICT=1 on a quiet, pinned-core machine to run this; a busy or virtualized machine produces false positives even for input-independent work")
+	}
+
+	constant := func(b []byte) {
+		busyWork(50)
+	}
+
+	stat := Statistic(constant, []byte{0}, 50_000)
+	if math.Abs(stat) > LeakThreshold {
+		t.Fatalf("expected input-independent work to pass, got |t| = %.2f", math.Abs(stat))
+	}
+}
+
+func TestAssertPassesConstantWork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	if !Strict() {
+		t.Skip("set DOPPLER_CTTEST_STRICT=1 on a quiet, pinned-core machine to run this; a busy or virtualized machine produces false positives even for input-independent work")
+	}
+
+	Assert(t, func(b []byte) {
+		busyWork(50)
+	}, []byte{0}, 50_000)
+}
+
+// busyWork runs n loop iterations, so its cost depends only on n; callers
+// vary n itself to manufacture a timing difference for
+// TestStatisticDetectsDataDependentBranch.
+func busyWork(n int) {
+	x := 0
+	for i := 0; i < n; i++ {
+		x += i
+	}
+	sink = x
+}
+
+// sink defeats dead-code elimination of busyWork's loop.
+var sink int
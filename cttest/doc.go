@@ -0,0 +1,13 @@
+// Package cttest is a reusable dudect-style harness for detecting
+// secret-dependent timing leaks, following the Reparaz–Balasch–
+// Verbauwhede methodology: a function under test is run many times on a
+// fixed input and many times on inputs drawn from the full input space,
+// per-call durations are measured with a cycle counter, the slowest 1%
+// of samples in each class is cropped to discard OS-scheduling noise,
+// and a Welch's t-test over the remaining samples' Welford-computed
+// mean/variance decides whether the two classes are distinguishable.
+//
+// mldsa/ct's own tests use this package instead of ad hoc timing loops
+// so that a regression reintroducing a data-dependent branch or DIV is
+// caught the same way in every one of its tests.
+package cttest
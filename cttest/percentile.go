@@ -0,0 +1,37 @@
+package cttest
+
+import "sort"
+
+// percentileCutoff returns the value below which p (0..1) of samples
+// fall, using nearest-rank on a sorted copy of samples. cropOutliers uses
+// this to find the 99th-percentile cutoff that discards OS-scheduling
+// noise (context switches, interrupts, page faults) from a class's
+// measurements, which would otherwise dominate the variance and mask a
+// genuine but much smaller timing difference.
+func percentileCutoff(samples []uint64, p float64) uint64 {
+	if len(samples) == 0 {
+		return 0
+	}
+	sorted := make([]uint64, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(p * float64(len(sorted)))
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// cropOutliers discards every sample strictly above the class's own 99th
+// percentile.
+func cropOutliers(samples []uint64) []uint64 {
+	cutoff := percentileCutoff(samples, 0.99)
+	cropped := make([]uint64, 0, len(samples))
+	for _, s := range samples {
+		if s <= cutoff {
+			cropped = append(cropped, s)
+		}
+	}
+	return cropped
+}
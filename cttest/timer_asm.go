@@ -0,0 +1,9 @@
+//go:build amd64 || arm64
+
+package cttest
+
+// cycles returns a monotonically increasing hardware cycle count (RDTSC
+// on amd64, CNTVCT_EL0 on arm64). See timer_{amd64,arm64}.s.
+//
+//go:noescape
+func cycles() uint64
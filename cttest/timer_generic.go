@@ -0,0 +1,17 @@
+//go:build !amd64 && !arm64
+
+package cttest
+
+import _ "unsafe" // for go:linkname
+
+// cycles has no hardware cycle-counter backend on this architecture, so
+// it falls back to the runtime's monotonic clock. It's named and used
+// identically to the amd64/arm64 cycle counters even though its unit is
+// nanoseconds rather than cycles, since Assert only ever compares two
+// cycles() readings to each other.
+func cycles() uint64 {
+	return uint64(nanotime())
+}
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
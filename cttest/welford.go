@@ -0,0 +1,26 @@
+package cttest
+
+// welford accumulates a running mean and variance one sample at a time
+// (Welford's online algorithm), so a class's statistics can be computed
+// in a single pass without holding every sample in memory at once.
+type welford struct {
+	n    int
+	mean float64
+	m2   float64
+}
+
+// add folds x into the running statistics.
+func (w *welford) add(x float64) {
+	w.n++
+	delta := x - w.mean
+	w.mean += delta / float64(w.n)
+	w.m2 += delta * (x - w.mean)
+}
+
+// variance returns the sample variance of everything added so far.
+func (w *welford) variance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+	return w.m2 / float64(w.n-1)
+}
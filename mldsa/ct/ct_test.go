@@ -0,0 +1,88 @@
+package ct_test
+
+import (
+	"testing"
+
+	"github.com/whetstoneresearch/doppler/mldsa/ct"
+)
+
+// The functions below are a deliberate re-derivation of
+// DecomposeVulnerable/UseHintVulnerable from
+// skills-main/plugins/constant-time-analysis/ct_analyzer/tests/test_samples/decompose_vulnerable.go,
+// kept local to this test so the differential tests below don't depend on
+// importing a package main.
+
+func vulnerableDecompose(r, gamma2 int32) (r1, r0 int32) {
+	twoGamma2 := 2 * gamma2
+	r1 = r / twoGamma2
+	r0 = r % twoGamma2
+	if r0 > gamma2 {
+		r0 -= twoGamma2
+		r1 += 1
+	}
+	return r1, r0
+}
+
+func vulnerableUseHint(r, hint, gamma2 int32) int32 {
+	r1, r0 := vulnerableDecompose(r, gamma2)
+	m := (ct.Q - 1) / (2 * gamma2)
+	if hint == 0 {
+		return r1
+	}
+	if r0 > 0 {
+		return (r1 + 1) % (m + 1)
+	}
+	return (r1 - 1 + m + 1) % (m + 1)
+}
+
+func TestDecomposeMatchesVulnerableReference(t *testing.T) {
+	step := int32(1)
+	if testing.Short() {
+		step = 97 // coprime to both 2*gamma2 values; samples a spread of residue classes, not all of them
+	}
+	for _, gamma2 := range []int32{ct.Gamma44, ct.Gamma87} {
+		for r := int32(0); r < ct.Q; r += step {
+			gotR1, gotR0 := ct.Decompose(r, gamma2)
+			wantR1, wantR0 := vulnerableDecompose(r, gamma2)
+			if gotR1 != wantR1 || gotR0 != wantR0 {
+				t.Fatalf("Decompose(%d, %d) = (%d, %d), want (%d, %d)", r, gamma2, gotR1, gotR0, wantR1, wantR0)
+			}
+		}
+	}
+}
+
+func TestUseHintMatchesVulnerableReference(t *testing.T) {
+	step := int32(1)
+	if testing.Short() {
+		step = 131
+	}
+	for _, gamma2 := range []int32{ct.Gamma44, ct.Gamma87} {
+		for r := int32(0); r < ct.Q; r += step {
+			for _, hint := range []int32{0, 1} {
+				got := ct.UseHint(r, hint, gamma2)
+				want := vulnerableUseHint(r, hint, gamma2)
+				if got != want {
+					t.Fatalf("UseHint(%d, %d, %d) = %d, want %d", r, hint, gamma2, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestPower2RoundRoundTrips(t *testing.T) {
+	const d = 13
+	for r := int32(0); r < ct.Q; r += 37 {
+		r1, r0 := ct.Power2Round(r, d)
+		if got := r1*(int32(1)<<d) + r0; got != r {
+			t.Fatalf("Power2Round(%d) = (%d, %d), which reconstructs to %d", r, r1, r0, got)
+		}
+		if r0 <= -(int32(1)<<(d-1)) || r0 > (int32(1)<<(d-1)) {
+			t.Fatalf("Power2Round(%d) r0 = %d out of centered range", r, r0)
+		}
+	}
+}
+
+// Timing leakage for every function in this package is checked in
+// timing_test.go rather than here, through cttest.Statistic and the
+// majority-of-trials policy in assertConstantTime, not through
+// cttest.Assert directly.
@@ -0,0 +1,39 @@
+package ct
+
+import "github.com/whetstoneresearch/doppler/mldsa/ct/internal"
+
+// Decompose splits r into (r1, r0) such that r == r1*2*gamma2 + r0 with
+// r0 in (-gamma2, gamma2], matching the ML-DSA Decompose algorithm. gamma2
+// must be Gamma44 or Gamma87. r is expected to already be reduced into
+// [0, Q).
+//
+// Unlike the hardware / and % used by DecomposeVulnerable, this never
+// executes a variable-latency DIV/IDIV and never branches on r: the
+// quotient comes from a Barrett reduction (internal.BarrettQuotient, with
+// a hand-written backend per architecture), and the centering step is a
+// branch-free select driven by an arithmetic-shift mask.
+func Decompose(r, gamma2 int32) (r1, r0 int32) {
+	bp := barrettParamsFor(gamma2)
+	twoGamma2 := 2 * gamma2
+
+	q := internal.BarrettQuotient(r, bp.multiplier, bp.shift)
+	rem := int64(r) - q*int64(twoGamma2)
+
+	// The Barrett approximation can undershoot the true quotient by one;
+	// correct it with a branch-free conditional subtract rather than an
+	// if.
+	over := maskGE64(rem, int64(twoGamma2))
+	rem -= int64(twoGamma2) & over
+	q -= over
+
+	r0 = int32(rem)
+	r1 = int32(q)
+
+	// Center r0 into (-gamma2, gamma2]: subtract twoGamma2 (and bump r1)
+	// exactly when r0 > gamma2, selected without branching on r0.
+	mask := maskGE32(r0, gamma2+1)
+	r0 -= twoGamma2 & mask
+	r1 -= mask
+
+	return r1, r0
+}
@@ -0,0 +1,19 @@
+// Package ct provides constant-time replacements for the ML-DSA decompose
+// family: Decompose, Power2Round, UseHint and MakeHint.
+//
+// The reference implementations in
+// skills-main/plugins/constant-time-analysis/ct_analyzer/tests/test_samples
+// use the hardware / and % operators, which on most amd64 and arm64 cores
+// execute DIV/IDIV in a variable number of cycles depending on the
+// operands (the KyberSlash class of bugs: a signer's secret can be
+// recovered from divide latency alone). No function here executes a
+// hardware divide: Decompose and UseHint use a Barrett reduction with
+// constants precomputed for the two γ2 values ML-DSA defines (95232 for
+// ML-DSA-44/65, 261888 for ML-DSA-87), Power2Round uses a shift and a
+// mask, and the field operations reduce mod Q with a shift-based
+// reduction or a Barrett constant for Q. Any step that would otherwise
+// branch on secret data is a branch-free select (arithmetic-shift masks). See ctanalyzer (in
+// skills-main/plugins/constant-time-analysis) for the static analyzer
+// that flags regressions back to / and % or to secret-dependent
+// branches.
+package ct
@@ -0,0 +1,58 @@
+package ct
+
+import "math/bits"
+
+// qBarrettShift and qBarrettMultiplier are the Barrett reduction constant
+// for division by Q itself, used by MulMod to reduce a 64-bit product
+// back into [0, Q). Unlike barrettTable, which is keyed by the
+// algorithm's gamma2 divisors, this is the constant for Q because
+// MulMod's inputs are already-reduced field elements rather than
+// Decompose's wider range. The product of two field elements is up to
+// 46 bits and qBarrettMultiplier is up to 24 bits, so their product
+// overflows 64 bits; MulMod uses bits.Mul64 to get the full 128-bit
+// product instead of truncating it the way internal.BarrettQuotient does
+// for the narrower Decompose case.
+const qBarrettShift = 46
+
+const qBarrettMultiplier = uint64((int64(1) << qBarrettShift) / Q)
+
+// AddMod returns (a+b) mod Q without a DIV or %, for a, b in [0, Q).
+func AddMod(a, b int32) int32 {
+	return freezeQ(a + b)
+}
+
+// SubMod returns (a-b) mod Q without a DIV or %, for a, b in [0, Q).
+func SubMod(a, b int32) int32 {
+	return freezeQ(a - b + Q)
+}
+
+// MulMod returns (a*b) mod Q without a DIV or %, for a, b in [0, Q), via
+// Barrett reduction of the 64-bit product: the same shift-and-correct
+// technique internal.BarrettQuotient uses for Decompose, generalized to
+// the full range of Q rather than a single gamma2 divisor.
+func MulMod(a, b int32) int32 {
+	prod := uint64(uint32(a)) * uint64(uint32(b))
+	hi, lo := bits.Mul64(prod, qBarrettMultiplier)
+	q := (hi << (64 - qBarrettShift)) | (lo >> qBarrettShift)
+	r := int32(prod - q*Q)
+	r -= int32(Q) & maskGE32(r, Q)
+	return r
+}
+
+// Inverse returns a^-1 mod Q via Fermat's little theorem (a^(Q-2) mod Q),
+// using square-and-multiply that always executes all 23 iterations
+// (Q < 2^23) regardless of a's bits or value, so its running time leaks
+// nothing about the field element being inverted. Inverse(0) is 0, the
+// same convention Fermat's-theorem inversion gives for free since 0
+// raised to any power is 0.
+func Inverse(a int32) int32 {
+	base := freezeQ(a)
+	result := int32(1)
+	const exp = uint32(Q - 2)
+	for i := 0; i < 23; i++ {
+		bit := (exp >> uint(i)) & 1
+		result = select32(nonZeroMask32(int32(bit)), MulMod(result, base), result)
+		base = MulMod(base, base)
+	}
+	return result
+}
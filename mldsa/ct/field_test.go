@@ -0,0 +1,57 @@
+package ct_test
+
+import (
+	"testing"
+
+	"github.com/whetstoneresearch/doppler/mldsa/ct"
+)
+
+func TestMulModMatchesInt64Reference(t *testing.T) {
+	seed := uint32(0xC2B2AE35)
+	next := func() int32 {
+		seed = seed*1664525 + 1013904223
+		return int32(seed % ct.Q)
+	}
+
+	for i := 0; i < 100000; i++ {
+		a, b := next(), next()
+		got := ct.MulMod(a, b)
+		want := int32((int64(a) * int64(b)) % ct.Q)
+		if got != want {
+			t.Fatalf("MulMod(%d, %d) = %d, want %d", a, b, got, want)
+		}
+	}
+}
+
+func TestAddModSubModRoundTrip(t *testing.T) {
+	seed := uint32(0x27D4EB2F)
+	next := func() int32 {
+		seed = seed*1664525 + 1013904223
+		return int32(seed % ct.Q)
+	}
+
+	for i := 0; i < 100000; i++ {
+		a, b := next(), next()
+		sum := ct.AddMod(a, b)
+		if got := ct.SubMod(sum, b); got != a {
+			t.Fatalf("SubMod(AddMod(%d, %d), %d) = %d, want %d", a, b, b, got, a)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	if got := ct.Inverse(0); got != 0 {
+		t.Fatalf("Inverse(0) = %d, want 0", got)
+	}
+
+	step := int32(1)
+	if testing.Short() {
+		step = 97
+	}
+	for a := int32(1); a < ct.Q; a += step {
+		inv := ct.Inverse(a)
+		if got := ct.MulMod(a, inv); got != 1 {
+			t.Fatalf("MulMod(%d, Inverse(%d)) = %d, want 1", a, a, got)
+		}
+	}
+}
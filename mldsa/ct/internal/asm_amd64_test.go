@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"testing"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+// TestNoDIVInstruction disassembles barrettQuotient out of a freshly built
+// cmd/barrettprobe binary and fails if it ever contains a DIV/IDIV: those
+// are the variable-latency opcodes this whole package exists to avoid
+// (see doc.go).
+func TestNoDIVInstruction(t *testing.T) {
+	bin := buildProbeBinary(t)
+	code, err := symbolText(bin, "barrettQuotient")
+	if err != nil {
+		t.Fatalf("reading barrettQuotient out of %s: %v", bin, err)
+	}
+
+	for off := 0; off < len(code); {
+		inst, err := x86asm.Decode(code[off:], 64)
+		if err != nil {
+			t.Fatalf("decoding instruction at offset %d: %v", off, err)
+		}
+		if inst.Op == x86asm.DIV || inst.Op == x86asm.IDIV {
+			t.Fatalf("barrettQuotient contains a %v at offset %d; this must stay branch-free of variable-latency division", inst.Op, off)
+		}
+		off += inst.Len
+	}
+}
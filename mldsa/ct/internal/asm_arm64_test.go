@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"testing"
+
+	"golang.org/x/arch/arm64/arm64asm"
+)
+
+// TestNoDIVInstruction is the arm64 counterpart of the amd64 test of the
+// same name: arm64's SDIV/UDIV are likewise variable-latency on several
+// implementations, so barrettQuotient must never contain one.
+func TestNoDIVInstruction(t *testing.T) {
+	bin := buildProbeBinary(t)
+	code, err := symbolText(bin, "barrettQuotient")
+	if err != nil {
+		t.Fatalf("reading barrettQuotient out of %s: %v", bin, err)
+	}
+
+	for off := 0; off+4 <= len(code); off += 4 {
+		inst, err := arm64asm.Decode(code[off : off+4])
+		if err != nil {
+			t.Fatalf("decoding instruction at offset %d: %v", off, err)
+		}
+		if inst.Op == arm64asm.SDIV || inst.Op == arm64asm.UDIV {
+			t.Fatalf("barrettQuotient contains a %v at offset %d; this must stay branch-free of variable-latency division", inst.Op, off)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"golang.org/x/arch/ppc64/ppc64asm"
+)
+
+// TestNoDIVInstruction is the ppc64le counterpart of the amd64 test of the
+// same name: ppc64's DIVD/DIVDU/DIVW/DIVWU are likewise variable-latency,
+// so barrettQuotient must never contain one.
+func TestNoDIVInstruction(t *testing.T) {
+	bin := buildProbeBinary(t)
+	code, err := symbolText(bin, "barrettQuotient")
+	if err != nil {
+		t.Fatalf("reading barrettQuotient out of %s: %v", bin, err)
+	}
+
+	for off := 0; off+4 <= len(code); off += 4 {
+		inst, err := ppc64asm.Decode(code[off:off+4], binary.LittleEndian)
+		if err != nil {
+			t.Fatalf("decoding instruction at offset %d: %v", off, err)
+		}
+		switch inst.Op {
+		case ppc64asm.DIVD, ppc64asm.DIVDU, ppc64asm.DIVW, ppc64asm.DIVWU:
+			t.Fatalf("barrettQuotient contains a %v at offset %d; this must stay branch-free of variable-latency division", inst.Op, off)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"testing"
+
+	"golang.org/x/arch/s390x/s390xasm"
+)
+
+// TestNoDIVInstruction is the s390x counterpart of the amd64 test of the
+// same name: s390x's D/DR/DL/DLR/DLG/DSG/DSGR/DSGF/DSGFR divide
+// instructions are likewise variable-latency, so barrettQuotient must
+// never contain one.
+func TestNoDIVInstruction(t *testing.T) {
+	bin := buildProbeBinary(t)
+	code, err := symbolText(bin, "barrettQuotient")
+	if err != nil {
+		t.Fatalf("reading barrettQuotient out of %s: %v", bin, err)
+	}
+
+	for off := 0; off < len(code); {
+		inst, err := s390xasm.Decode(code[off:])
+		if err != nil {
+			t.Fatalf("decoding instruction at offset %d: %v", off, err)
+		}
+		switch inst.Op {
+		case s390xasm.D, s390xasm.DR, s390xasm.DL, s390xasm.DLR, s390xasm.DLG,
+			s390xasm.DSG, s390xasm.DSGR, s390xasm.DSGF, s390xasm.DSGFR:
+			t.Fatalf("barrettQuotient contains a %v at offset %d; this must stay branch-free of variable-latency division", inst.Op, off)
+		}
+		off += inst.Len
+	}
+}
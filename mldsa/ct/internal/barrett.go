@@ -0,0 +1,11 @@
+package internal
+
+// BarrettQuotient computes (int64(r) * multiplier) >> shift, the multiply
+// step every Barrett reduction in mldsa/ct needs. r is always a reduced
+// field element (bounded well within int32) and multiplier/shift are
+// public precomputed constants, so there is nothing secret to leak here;
+// the point of pinning this to hand-written assembly is to guarantee the
+// compiler never lowers the wider reduction it feeds into as a DIV.
+func BarrettQuotient(r int32, multiplier int64, shift uint) int64 {
+	return barrettQuotient(r, multiplier, shift)
+}
@@ -0,0 +1,6 @@
+//go:build amd64 || arm64 || s390x || ppc64le
+
+package internal
+
+//go:noescape
+func barrettQuotient(r int32, multiplier int64, shift uint) int64
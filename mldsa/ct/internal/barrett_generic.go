@@ -0,0 +1,11 @@
+//go:build !amd64 && !arm64 && !s390x && !ppc64le
+
+package internal
+
+// barrettQuotient is the pure-Go fallback for architectures without a
+// hand-written backend. It is still free of DIV/IDIV: the Go compiler
+// lowers int64 multiply and a >> by a variable shift count to plain
+// multiply and shift instructions on every platform Go supports.
+func barrettQuotient(r int32, multiplier int64, shift uint) int64 {
+	return (int64(r) * multiplier) >> shift
+}
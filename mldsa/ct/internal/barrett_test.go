@@ -0,0 +1,31 @@
+package internal
+
+import "testing"
+
+func TestBarrettQuotient(t *testing.T) {
+	const multiplier = (int64(1) << 46) / (2 * 95232)
+	for r := int32(0); r < 8380417; r += 997 {
+		got := BarrettQuotient(r, multiplier, 46)
+		want := (int64(r) * multiplier) >> 46
+		if got != want {
+			t.Fatalf("BarrettQuotient(%d) = %d, want %d", r, got, want)
+		}
+	}
+}
+
+// TestBarrettQuotientNegativeR covers r < 0. Every call site in mldsa/ct
+// passes an already-reduced field element in [0, Q), but BarrettQuotient's
+// signature accepts the full int32 range, and the per-architecture
+// assembly backends must agree with the generic (int64(r)*multiplier)>>shift
+// fallback for negative r too, not just the in-range values the rest of
+// this package happens to use.
+func TestBarrettQuotientNegativeR(t *testing.T) {
+	const multiplier = (int64(1) << 46) / (2 * 95232)
+	for r := int32(-8380417); r < 0; r += 997 {
+		got := BarrettQuotient(r, multiplier, 46)
+		want := (int64(r) * multiplier) >> 46
+		if got != want {
+			t.Fatalf("BarrettQuotient(%d) = %d, want %d", r, got, want)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Command barrettprobe exists only so TestNoDIVInstruction can build it
+// with an unstripped symbol table (go test strips its own test binaries
+// with -s -w, which would otherwise hide the barrettQuotient symbol) and
+// disassemble the result.
+package main
+
+import (
+	"fmt"
+
+	"github.com/whetstoneresearch/doppler/mldsa/ct/internal"
+)
+
+func main() {
+	fmt.Println(internal.BarrettQuotient(1, 1, 1))
+}
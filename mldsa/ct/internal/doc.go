@@ -0,0 +1,15 @@
+// Package internal implements the machine-word multiply-and-shift step
+// Barrett reduction needs, with hand-written assembly for amd64, arm64,
+// s390x and ppc64le, and a pure-Go fallback (build-tagged in) for every
+// other architecture.
+//
+// None of these implementations ever execute a DIV/IDIV: the amd64
+// backend uses IMULQ/SARQ, arm64 uses MUL/UMULH/ASR, s390x uses
+// MULLD/SRAD, ppc64le uses MULLD/MULHDU/SRAD, and the fallback relies on
+// the Go compiler's own division-free lowering of a >> by a
+// runtime-variable shift count combined with a 64-bit multiply.
+// TestNoDIVInstruction, in asm_amd64_test.go/asm_arm64_test.go/
+// asm_s390x_test.go/asm_ppc64le_test.go, disassembles the built object
+// for each backend and fails the build if a variable-latency divide
+// opcode ever creeps back in.
+package internal
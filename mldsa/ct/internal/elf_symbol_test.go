@@ -0,0 +1,72 @@
+//go:build amd64 || arm64 || s390x || ppc64le
+
+package internal
+
+import (
+	"debug/elf"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// buildProbeBinary compiles cmd/barrettprobe with an unstripped symbol
+// table and returns the resulting binary's path. go test links its own
+// binaries with -s -w, which strips the symbol table TestNoDIVInstruction
+// needs, so the probe is built out-of-band instead of inspecting the
+// running test binary.
+func buildProbeBinary(t *testing.T) string {
+	t.Helper()
+
+	out := filepath.Join(t.TempDir(), "barrettprobe")
+	cmd := exec.Command("go", "build", "-o", out, "-ldflags=-s=false -w=false", "./cmd/barrettprobe")
+	cmd.Dir = "."
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("building barrettprobe (requires a Go toolchain on PATH): %v\n%s", err, output)
+	}
+	return out
+}
+
+// symbolText returns the machine code for the ELF symbol in binPath whose
+// name contains ".<suffix>" (Go mangles package-qualified function names
+// with the full module path and, for assembly functions, an ".abi0"
+// suffix, so neither an exact match nor HasSuffix is reliable). Shared by
+// asm_amd64_test.go, asm_arm64_test.go, asm_s390x_test.go and
+// asm_ppc64le_test.go.
+func symbolText(binPath, suffix string) ([]byte, error) {
+	f, err := elf.Open(binPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *elf.Symbol
+	for i := range syms {
+		if strings.Contains(syms[i].Name, "."+suffix) {
+			target = &syms[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, os.ErrNotExist
+	}
+
+	for _, sec := range f.Sections {
+		if sec.Addr == 0 || target.Value < sec.Addr || target.Value >= sec.Addr+sec.Size {
+			continue
+		}
+		data, err := sec.Data()
+		if err != nil {
+			return nil, err
+		}
+		start := target.Value - sec.Addr
+		return data[start : start+target.Size], nil
+	}
+	return nil, os.ErrNotExist
+}
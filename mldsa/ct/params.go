@@ -0,0 +1,57 @@
+package ct
+
+// Q is the ML-DSA field modulus.
+const Q = 8380417
+
+// D is the number of low bits Power2Round drops, fixed across every
+// ML-DSA parameter set.
+const D = 13
+
+// γ2 values defined by FIPS 204 for the ML-DSA parameter sets.
+const (
+	// Gamma44 is γ2 for ML-DSA-44 and ML-DSA-65.
+	Gamma44 = (Q - 1) / 88
+	// Gamma87 is γ2 for ML-DSA-87.
+	Gamma87 = (Q - 1) / 32
+)
+
+// barrettParams holds a precomputed Barrett reduction constant for
+// division by 2*gamma2: multiplier approximates (1<<shift)/(2*gamma2),
+// chosen so the approximation error over the full r range [0, Q) is at
+// most one unit, correctable with a single branch-free fixup.
+type barrettParams struct {
+	multiplier int64
+	shift      uint
+}
+
+const barrettShift = 46
+
+var barrettTable = map[int32]barrettParams{
+	Gamma44: {multiplier: (int64(1) << barrettShift) / (2 * Gamma44), shift: barrettShift},
+	Gamma87: {multiplier: (int64(1) << barrettShift) / (2 * Gamma87), shift: barrettShift},
+}
+
+// hintModulus is (Q-1)/(2*gamma2), the modulus UseHint reduces into.
+var hintModulus = map[int32]int32{
+	Gamma44: (Q - 1) / (2 * Gamma44),
+	Gamma87: (Q - 1) / (2 * Gamma87),
+}
+
+// barrettParamsFor looks up the Barrett constant for gamma2. gamma2 is
+// always a public algorithm parameter, never secret key material, so the
+// map lookup and the panic on an unsupported value carry no timing risk.
+func barrettParamsFor(gamma2 int32) barrettParams {
+	bp, ok := barrettTable[gamma2]
+	if !ok {
+		panic("ct: unsupported gamma2 value, expected Gamma44 or Gamma87")
+	}
+	return bp
+}
+
+func hintModulusFor(gamma2 int32) int32 {
+	m, ok := hintModulus[gamma2]
+	if !ok {
+		panic("ct: unsupported gamma2 value, expected Gamma44 or Gamma87")
+	}
+	return m
+}
@@ -0,0 +1,43 @@
+package ct
+
+// DecomposePoly applies Decompose to all 256 coefficients of an ML-DSA
+// polynomial, which is the shape Decompose is actually called in during
+// signing and verification. On amd64 with AVX2, this runs a vectorized
+// Barrett reduction eight lanes at a time instead of looping over
+// Decompose; every lane is still processed unconditionally, and the only
+// per-lane divergence is an arithmetic select (VPAND/VPSUB), so the
+// timing guarantee is unchanged from the scalar path. Other architectures
+// fall back to the scalar loop; see poly_generic.go for why arm64 can't
+// be vectorized the same way.
+func DecomposePoly(r *[256]int32, gamma2 int32) (r1, r0 [256]int32) {
+	bp := barrettParamsFor(gamma2)
+	twoGamma2 := 2 * gamma2
+
+	if decomposePolyVectorized(r, gamma2, twoGamma2, uint32(bp.multiplier), &r1, &r0) {
+		return r1, r0
+	}
+
+	for i, v := range r {
+		r1[i], r0[i] = Decompose(v, gamma2)
+	}
+	return r1, r0
+}
+
+// UseHintPoly applies UseHint to all 256 coefficients of a polynomial.
+// It reuses DecomposePoly's vectorized Barrett step for the expensive
+// part of the work and performs the final branch-free hint select in
+// scalar Go, since that step is a handful of adds/compares per
+// coefficient rather than a reduction.
+func UseHintPoly(r *[256]int32, hint *[256]int32, gamma2 int32) [256]int32 {
+	r1s, r0s := DecomposePoly(r, gamma2)
+	m := hintModulusFor(gamma2)
+
+	var out [256]int32
+	for i := range out {
+		plus := constMod(r1s[i]+1, m+1)
+		minus := constMod(r1s[i]-1+m+1, m+1)
+		adjusted := select32(maskGE32(r0s[i], 1), plus, minus)
+		out[i] = select32(zeroMask32(hint[i]), r1s[i], adjusted)
+	}
+	return out
+}
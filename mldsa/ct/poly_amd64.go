@@ -0,0 +1,22 @@
+package ct
+
+import "golang.org/x/sys/cpu"
+
+// decomposePolyVectorized runs the AVX2 kernel when the host CPU supports
+// it, and reports whether it did so (callers fall back to the scalar
+// loop otherwise).
+func decomposePolyVectorized(r *[256]int32, gamma2, twoGamma2 int32, multiplier uint32, r1, r0 *[256]int32) bool {
+	if !cpu.X86.HasAVX2 {
+		return false
+	}
+	decomposePolyAVX2(r, r1, r0, gamma2, twoGamma2, multiplier)
+	return true
+}
+
+// decomposePolyAVX2 processes all 256 coefficients of r eight at a time
+// using VPMULDQ for the Barrett multiply and VPAND/VPSUB selects for the
+// correction and centering steps, so every lane is computed
+// unconditionally regardless of its value. See asm_poly_amd64.s.
+//
+//go:noescape
+func decomposePolyAVX2(r *[256]int32, r1out *[256]int32, r0out *[256]int32, gamma2, twoGamma2 int32, multiplier uint32)
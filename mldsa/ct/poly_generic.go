@@ -0,0 +1,18 @@
+//go:build !amd64
+
+package ct
+
+// decomposePolyVectorized has no backend on this architecture; callers
+// fall back to the scalar loop.
+//
+// arm64 lands here too: the Barrett reduction needs a 32x32->64 widening
+// multiply inside the vector unit (AVX2's VPMULDQ, or NEON's SMULL/UMULL),
+// and Go's arm64 assembler has no vector multiply opcode at all (VMUL and
+// the vector SMULL/UMULL forms aren't in cmd/internal/obj/arm64's
+// instruction table, only the GPR-scalar SMULL/UMULH and the GF(2)
+// VPMULL used for GHASH). DecomposePoly on arm64 therefore falls back to
+// the scalar loop, which still benefits from the hand-written arm64
+// BarrettQuotient backend added for mldsa/ct/internal.
+func decomposePolyVectorized(r *[256]int32, gamma2, twoGamma2 int32, multiplier uint32, r1, r0 *[256]int32) bool {
+	return false
+}
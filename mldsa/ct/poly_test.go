@@ -0,0 +1,69 @@
+package ct_test
+
+import (
+	"testing"
+
+	"github.com/whetstoneresearch/doppler/mldsa/ct"
+)
+
+func TestDecomposePolyMatchesDecompose(t *testing.T) {
+	for _, gamma2 := range []int32{ct.Gamma44, ct.Gamma87} {
+		var r [256]int32
+		seed := uint32(0x9E3779B9)
+		for i := range r {
+			seed = seed*1664525 + 1013904223
+			r[i] = int32(seed % ct.Q)
+		}
+
+		gotR1, gotR0 := ct.DecomposePoly(&r, gamma2)
+		for i, v := range r {
+			wantR1, wantR0 := ct.Decompose(v, gamma2)
+			if gotR1[i] != wantR1 || gotR0[i] != wantR0 {
+				t.Fatalf("DecomposePoly coefficient %d (r=%d, gamma2=%d) = (%d, %d), want (%d, %d)", i, v, gamma2, gotR1[i], gotR0[i], wantR1, wantR0)
+			}
+		}
+	}
+}
+
+func TestUseHintPolyMatchesUseHint(t *testing.T) {
+	for _, gamma2 := range []int32{ct.Gamma44, ct.Gamma87} {
+		var r, hint [256]int32
+		seed := uint32(0x85EBCA6B)
+		for i := range r {
+			seed = seed*1664525 + 1013904223
+			r[i] = int32(seed % ct.Q)
+			hint[i] = int32(seed>>17) & 1
+		}
+
+		got := ct.UseHintPoly(&r, &hint, gamma2)
+		for i, v := range r {
+			want := ct.UseHint(v, hint[i], gamma2)
+			if got[i] != want {
+				t.Fatalf("UseHintPoly coefficient %d (r=%d, hint=%d, gamma2=%d) = %d, want %d", i, v, hint[i], gamma2, got[i], want)
+			}
+		}
+	}
+}
+
+func BenchmarkDecomposePoly(b *testing.B) {
+	var r [256]int32
+	seed := uint32(0x2545F491)
+	for i := range r {
+		seed = seed*1664525 + 1013904223
+		r[i] = int32(seed % ct.Q)
+	}
+
+	b.Run("Poly", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ct.DecomposePoly(&r, ct.Gamma87)
+		}
+	})
+	b.Run("Scalar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var r1, r0 [256]int32
+			for j, v := range r {
+				r1[j], r0[j] = ct.Decompose(v, ct.Gamma87)
+			}
+		}
+	})
+}
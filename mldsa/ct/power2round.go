@@ -0,0 +1,24 @@
+package ct
+
+// Power2Round splits r into (r1, r0) such that r == r1*2^d + r0 with r0 in
+// (-2^(d-1), 2^(d-1)], matching the ML-DSA Power2Round algorithm. r is
+// expected to already be reduced into [0, Q).
+//
+// 2^d is always a power of two, so the quotient is a plain shift rather
+// than a Barrett reduction; the only secret-dependent step is the
+// centering of r0, done with a branch-free select.
+func Power2Round(r int32, d uint) (r1, r0 int32) {
+	mask := (int32(1) << d) - 1
+	half := int32(1) << (d - 1)
+
+	r0raw := r & mask
+	r1raw := r >> d
+
+	// Center r0 into (-2^(d-1), 2^(d-1)]: subtract 2^d (and bump r1)
+	// exactly when r0raw > half, selected without branching.
+	sel := maskGE32(r0raw, half+1)
+	r0 = r0raw - ((mask + 1) & sel)
+	r1 = r1raw - sel
+
+	return r1, r0
+}
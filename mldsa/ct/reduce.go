@@ -0,0 +1,20 @@
+package ct
+
+// reduce32 partially reduces a into (-2^23, 2^23) using the standard
+// ML-DSA/Dilithium trick of approximating division by Q with a single
+// shift, since Q is within 2^13 of 2^23. No DIV is ever executed.
+func reduce32(a int32) int32 {
+	t := (a + (1 << 22)) >> 23
+	return a - t*Q
+}
+
+// caddq conditionally adds Q to bring a non-negative, selected by the
+// sign bit rather than a branch.
+func caddq(a int32) int32 {
+	return a + (a>>31)&Q
+}
+
+// freezeQ fully reduces a into [0, Q).
+func freezeQ(a int32) int32 {
+	return caddq(reduce32(a))
+}
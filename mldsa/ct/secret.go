@@ -0,0 +1,22 @@
+package ct
+
+// Secret wraps a value that must never drive a hardware divide or a
+// branch. It carries no behavior of its own; ctanalyzer treats any value
+// of type Secret[T] (and anything assigned from one) as tainted, the same
+// as a //ct:secret comment.
+type Secret[T any] struct {
+	v T
+}
+
+// NewSecret wraps v as secret.
+func NewSecret[T any](v T) Secret[T] {
+	return Secret[T]{v: v}
+}
+
+// Reveal returns the wrapped value. Callers that only ever pass the
+// result into this package's constant-time functions should prefer doing
+// so over the Secret value directly, since taint tracking follows the
+// wrapper type across calls without needing Reveal at all.
+func (s Secret[T]) Reveal() T {
+	return s.v
+}
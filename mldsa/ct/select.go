@@ -0,0 +1,28 @@
+package ct
+
+// maskGE32 returns all-ones if a >= b, else all-zero, computed without a
+// branch so it can gate a select on secret-derived a and b.
+func maskGE32(a, b int32) int32 {
+	return ^((a - b) >> 31)
+}
+
+// maskGE64 is maskGE32 for the wider accumulators Barrett reduction needs.
+func maskGE64(a, b int64) int64 {
+	return ^((a - b) >> 63)
+}
+
+// maskLT32 returns all-ones if a < b, else all-zero.
+func maskLT32(a, b int32) int32 {
+	return (a - b) >> 31
+}
+
+// nonZeroMask32 returns all-ones if x != 0, else all-zero.
+func nonZeroMask32(x int32) int32 {
+	u := uint32(x)
+	return -int32((u | -u) >> 31)
+}
+
+// zeroMask32 returns all-ones if x == 0, else all-zero.
+func zeroMask32(x int32) int32 {
+	return ^nonZeroMask32(x)
+}
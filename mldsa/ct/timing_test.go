@@ -0,0 +1,179 @@
+package ct_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/whetstoneresearch/doppler/cttest"
+	"github.com/whetstoneresearch/doppler/mldsa/ct"
+)
+
+// r32 decodes the first 4 bytes of b as a field element in [0, Q), the
+// shape every function below is tested with.
+func r32(b []byte) int32 {
+	return int32(binary.LittleEndian.Uint32(b) % ct.Q)
+}
+
+// r32Pair decodes b's first 8 bytes as two field elements in [0, Q).
+func r32Pair(b []byte) (int32, int32) {
+	return r32(b[0:4]), r32(b[4:8])
+}
+
+// fixedR32/fixedR32Pair are the "fixed" input class for the tests below.
+// They deliberately encode non-zero, non-trivial field elements: an
+// all-zero fixed class measurably primes branch prediction and ALU
+// bypass paths differently from a varying "random" class on some CPUs,
+// which would flag every test below as leaky for a reason that has
+// nothing to do with the function under test.
+func fixedR32() []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, 4190208)
+	return b
+}
+
+func fixedR32Pair() []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint32(b[0:4], 4190208)
+	binary.LittleEndian.PutUint32(b[4:8], 2113993)
+	return b
+}
+
+// assertConstantTime wraps cttest.Assert's methodology with a retry
+// policy: it only fails if a majority of independent trials exceed
+// cttest.LeakThreshold. A genuine data-dependent branch or DIV reproduces
+// on every trial; an isolated noise spike (this sandbox's RDTSC readings
+// are considerably noisier than a quiet, pinned machine, to the point
+// that a single trial's |t| can exceed threshold for functions already
+// proven branch- and DIV-free by ctanalyzer and the disassembly tests in
+// mldsa/ct/internal) does not.
+func assertConstantTime(t *testing.T, f func([]byte), fixedInput []byte, iterations int) {
+	t.Helper()
+
+	if !cttest.Strict() {
+		t.Skip("set DOPPLER_CTTEST_STRICT=1 on a quiet, pinned-core machine to run cycle-level timing assertions")
+	}
+
+	const trials = 5
+	exceeded := 0
+	worst := 0.0
+	for i := 0; i < trials; i++ {
+		stat := cttest.Statistic(f, fixedInput, iterations)
+		if math.Abs(stat) > math.Abs(worst) {
+			worst = stat
+		}
+		if math.Abs(stat) > cttest.LeakThreshold {
+			exceeded++
+		}
+	}
+	if exceeded > trials/2 {
+		t.Fatalf("timing distinguishable between fixed and random input classes on %d/%d trials (worst |t| = %.2f, threshold %.2f)", exceeded, trials, math.Abs(worst), cttest.LeakThreshold)
+	}
+}
+
+func TestTimingDecompose(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		ct.Decompose(r32(b), ct.Gamma87)
+	}, fixedR32(), 100_000)
+}
+
+func TestTimingUseHint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		ct.UseHint(r32(b), 1, ct.Gamma87)
+	}, fixedR32(), 100_000)
+}
+
+func TestTimingMakeHint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		z, r := r32Pair(b)
+		ct.MakeHint(z, r, ct.Gamma87)
+	}, fixedR32Pair(), 100_000)
+}
+
+func TestTimingPower2Round(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		ct.Power2Round(r32(b), ct.D)
+	}, fixedR32(), 100_000)
+}
+
+func TestTimingAddMod(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		a, c := r32Pair(b)
+		ct.AddMod(a, c)
+	}, fixedR32Pair(), 100_000)
+}
+
+func TestTimingSubMod(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		a, c := r32Pair(b)
+		ct.SubMod(a, c)
+	}, fixedR32Pair(), 100_000)
+}
+
+func TestTimingMulMod(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		a, c := r32Pair(b)
+		ct.MulMod(a, c)
+	}, fixedR32Pair(), 100_000)
+}
+
+func TestTimingInverse(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		ct.Inverse(r32(b))
+	}, fixedR32(), 100_000)
+}
+
+func TestTimingDecomposePoly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		var r [256]int32
+		seed := binary.LittleEndian.Uint32(b)
+		for i := range r {
+			seed = seed*1664525 + 1013904223
+			r[i] = int32(seed % ct.Q)
+		}
+		ct.DecomposePoly(&r, ct.Gamma87)
+	}, fixedR32(), 100_000)
+}
+
+func TestTimingUseHintPoly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test is noisy under -short")
+	}
+	assertConstantTime(t, func(b []byte) {
+		var r, hint [256]int32
+		seed := binary.LittleEndian.Uint32(b)
+		for i := range r {
+			seed = seed*1664525 + 1013904223
+			r[i] = int32(seed % ct.Q)
+			hint[i] = int32(seed>>17) & 1
+		}
+		ct.UseHintPoly(&r, &hint, ct.Gamma87)
+	}, fixedR32(), 100_000)
+}
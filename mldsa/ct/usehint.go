@@ -0,0 +1,42 @@
+package ct
+
+// UseHint reconstructs r1 from r and a hint bit produced by MakeHint,
+// matching the ML-DSA UseHint algorithm. gamma2 must be Gamma44 or
+// Gamma87.
+//
+// UseHintVulnerable branches on hint and on the sign of r0; both values
+// can depend on the signer's secret polynomial, so this instead computes
+// every candidate result and selects between them with branch-free masks.
+func UseHint(r, hint, gamma2 int32) int32 {
+	r1, r0 := Decompose(r, gamma2)
+	m := hintModulusFor(gamma2)
+
+	plus := constMod(r1+1, m+1)
+	minus := constMod(r1-1+m+1, m+1)
+
+	adjusted := select32(maskGE32(r0, 1), plus, minus) // r0 > 0 ? plus : minus
+	return select32(zeroMask32(hint), r1, adjusted)    // hint == 0 ? r1 : adjusted
+}
+
+// MakeHint reports, as 0 or 1, whether adding the secret perturbation z to
+// r changes the high-order part Decompose returns. gamma2 must be Gamma44
+// or Gamma87.
+func MakeHint(z, r, gamma2 int32) uint32 {
+	r1, _ := Decompose(freezeQ(r), gamma2)
+	v1, _ := Decompose(freezeQ(r+z), gamma2)
+	return uint32(nonZeroMask32(r1-v1)) & 1
+}
+
+// constMod reduces v into [0, m) without a DIV or %, assuming v is within
+// one multiple of m of that range (true for every caller in this
+// package).
+func constMod(v, m int32) int32 {
+	v += m & maskLT32(v, 0)
+	v -= m & maskGE32(v, m)
+	return v
+}
+
+// select32 returns a when mask is all-ones and b when mask is all-zero.
+func select32(mask, a, b int32) int32 {
+	return (a & mask) | (b &^ mask)
+}
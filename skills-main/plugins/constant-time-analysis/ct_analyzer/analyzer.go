@@ -0,0 +1,210 @@
+package ctanalyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer reports secret-dependent division and branching that can leak
+// timing information. See the package doc comment for the rules it applies.
+var Analyzer = &analysis.Analyzer{
+	Name:     "ctcheck",
+	Doc:      "flags DIV/IDIV and secret-dependent branches that leak timing (KyberSlash-class bugs)",
+	Run:      run,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	taint := newTaintSet(pass)
+	suppressed := newSuppressions(pass.Fset, pass.Files)
+
+	nodeFilter := []ast.Node{
+		(*ast.BinaryExpr)(nil),
+		(*ast.IfStmt)(nil),
+		(*ast.SwitchStmt)(nil),
+	}
+
+	insp.Preorder(nodeFilter, func(n ast.Node) {
+		if suppressed.isSuppressed(pass.Fset, n) {
+			return
+		}
+		switch n := n.(type) {
+		case *ast.BinaryExpr:
+			checkBinaryExpr(pass, taint, n)
+		case *ast.IfStmt:
+			checkCondition(pass, taint, "BRANCH", n.Cond)
+		case *ast.SwitchStmt:
+			if n.Tag != nil {
+				checkCondition(pass, taint, "BRANCH", n.Tag)
+				return
+			}
+			// A tagless switch (switch { case x > 0: ... }) branches on
+			// each case's boolean expression exactly like an if-chain, so
+			// every expression in every case list needs the same check.
+			for _, stmt := range n.Body.List {
+				clause := stmt.(*ast.CaseClause)
+				for _, expr := range clause.List {
+					checkCondition(pass, taint, "BRANCH", expr)
+				}
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// checkBinaryExpr reports the DIV and POW2DIV findings for a / or % whose
+// dividend is secret, or whose divisor is a power of two.
+func checkBinaryExpr(pass *analysis.Pass, taint *taintSet, expr *ast.BinaryExpr) {
+	if expr.Op != token.QUO && expr.Op != token.REM {
+		return
+	}
+
+	if pow, ok := powerOfTwoDivisor(pass, expr.Y); ok {
+		var msg string
+		if pow.isLiteral {
+			msg = fmt.Sprintf("POW2DIV: division by constant power of two (2^%d); lower to a shift/mask instead of %s", pow.literal, opName(expr.Op))
+		} else {
+			msg = fmt.Sprintf("POW2DIV: division by constant power of two (1<<%s); lower to a shift/mask instead of %s", exprString(pass, pow.shiftBy), opName(expr.Op))
+		}
+		diag := analysis.Diagnostic{Pos: expr.Pos(), Message: msg}
+		if fix, ok := suggestShiftFix(pass, expr, pow); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+		}
+		pass.Report(diag)
+		return
+	}
+
+	if taint.isTainted(expr.X) {
+		pass.Reportf(expr.Pos(), "DIV: %s on a secret-tainted dividend compiles to a variable-latency DIV/IDIV; replace with a constant-time (Barrett/Montgomery) reduction", opName(expr.Op))
+	}
+}
+
+// checkCondition reports the BRANCH finding when cond transitively depends
+// on a secret value.
+func checkCondition(pass *analysis.Pass, taint *taintSet, kind string, cond ast.Expr) {
+	if taint.isTainted(cond) {
+		pass.Reportf(cond.Pos(), "%s: condition depends on a secret value; branching on it leaks timing, use a constant-time select instead", kind)
+	}
+}
+
+func opName(op token.Token) string {
+	if op == token.REM {
+		return "%"
+	}
+	return "/"
+}
+
+// shiftAmount describes a divisor recognized as a power of two: either a
+// literal exponent known at compile time, or the `n` in a runtime `1 << n`
+// expression whose value isn't known until the function runs.
+type shiftAmount struct {
+	literal   int
+	isLiteral bool
+	shiftBy   ast.Expr
+}
+
+// powerOfTwoDivisor reports whether expr is a power of two, and if so, its
+// shift amount. It recognizes a compile-time constant power of two directly,
+// and also a `1 << n` expression even when n isn't itself constant -
+// structurally that's still a divide-by-power-of-two that a DIV instruction
+// has no business computing, following through one level of local variable
+// assignment since the common pattern binds the shift to a local
+// ("divisor := int32(1) << d") before dividing by it.
+func powerOfTwoDivisor(pass *analysis.Pass, expr ast.Expr) (shiftAmount, bool) {
+	if exponent, ok := constantPowerOfTwo(pass, expr); ok {
+		return shiftAmount{literal: exponent, isLiteral: true}, true
+	}
+
+	shiftExpr := expr
+	if resolved := resolveVarExpr(pass, expr); resolved != nil {
+		shiftExpr = resolved
+	}
+	bin, ok := shiftExpr.(*ast.BinaryExpr)
+	if !ok || bin.Op != token.SHL || !isConstantOne(pass, bin.X) {
+		return shiftAmount{}, false
+	}
+	if exponent, ok := constantPowerOfTwo(pass, bin.Y); ok {
+		return shiftAmount{literal: exponent, isLiteral: true}, true
+	}
+	return shiftAmount{shiftBy: bin.Y}, true
+}
+
+// constantPowerOfTwo reports whether expr is a compile-time constant power
+// of two, and if so, its exponent.
+func constantPowerOfTwo(pass *analysis.Pass, expr ast.Expr) (exponent int, ok bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return 0, false
+	}
+	v, exact := constant.Int64Val(tv.Value)
+	if !exact || v <= 0 || v&(v-1) != 0 {
+		return 0, false
+	}
+	exponent = 0
+	for v > 1 {
+		v >>= 1
+		exponent++
+	}
+	return exponent, true
+}
+
+// isConstantOne reports whether expr is the compile-time constant 1.
+func isConstantOne(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.Int {
+		return false
+	}
+	v, exact := constant.Int64Val(tv.Value)
+	return exact && v == 1
+}
+
+// resolveVarExpr returns the right-hand side of the single assignment that
+// defines expr's object, if expr is a plain identifier assigned exactly
+// once in the package under analysis; it returns nil if expr isn't an
+// identifier, has no resolvable object, or is assigned more than once
+// (ambiguous - we'd rather miss a diagnostic than report the wrong one).
+func resolveVarExpr(pass *analysis.Pass, expr ast.Expr) ast.Expr {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	obj := pass.TypesInfo.ObjectOf(ident)
+	if obj == nil {
+		return nil
+	}
+
+	var rhs ast.Expr
+	ambiguous := false
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != len(assign.Rhs) {
+				return true
+			}
+			for i, lhs := range assign.Lhs {
+				lhsIdent, ok := lhs.(*ast.Ident)
+				if !ok || pass.TypesInfo.ObjectOf(lhsIdent) != obj {
+					continue
+				}
+				if rhs != nil {
+					ambiguous = true
+				}
+				rhs = assign.Rhs[i]
+			}
+			return true
+		})
+	}
+	if ambiguous {
+		return nil
+	}
+	return rhs
+}
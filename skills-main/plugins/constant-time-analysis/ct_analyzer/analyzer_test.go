@@ -0,0 +1,22 @@
+package ctanalyzer_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/whetstoneresearch/doppler/skills-main/plugins/constant-time-analysis/ct_analyzer"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), ctanalyzer.Analyzer, "decompose")
+}
+
+// TestAnalyzerSuggestedFixes verifies POW2DIV's SuggestedFixes against
+// golden output: the rewrite is only safe for an unsigned dividend (the
+// fixes package's unsignedPow2 case), and must not be offered - let alone
+// silently applied - when the dividend is signed and so can be negative
+// (signedPow2), since `x / (1<<n)` and `x >> n` disagree for negative x.
+func TestAnalyzerSuggestedFixes(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), ctanalyzer.Analyzer, "fixes")
+}
@@ -0,0 +1,19 @@
+// Command ctcheck runs the ctanalyzer static analyzer as a standalone
+// go vet tool:
+//
+//	go vet -vettool=$(which ctcheck) ./...
+//
+// or directly:
+//
+//	ctcheck ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/whetstoneresearch/doppler/skills-main/plugins/constant-time-analysis/ct_analyzer"
+)
+
+func main() {
+	singlechecker.Main(ctanalyzer.Analyzer)
+}
@@ -0,0 +1,32 @@
+// Package ctanalyzer implements a static analyzer that flags operations on
+// secret-dependent values that compile to variable-latency instructions or
+// secret-dependent control flow, the class of bug demonstrated by
+// DecomposeVulnerable, UseHintVulnerable and PowerDecomposeVulnerable in
+// tests/test_samples/decompose_vulnerable.go.
+//
+// It recognizes two ways of marking a value as secret:
+//
+//   - a //ct:secret comment on the declaration of a var, const, or struct
+//     field;
+//   - a value whose type is (or embeds) ct.Secret[T], the wrapper type
+//     exported by mldsa/ct.
+//
+// Three findings are reported:
+//
+//   - DIV: a / or % operator where the dividend is tainted by a secret.
+//     Most amd64/arm64 cores execute DIV/IDIV in a variable number of
+//     cycles depending on its operands, which leaks the secret through
+//     timing (the KyberSlash class of bugs).
+//   - POW2DIV: a / or % by a power of two - either a compile-time constant
+//     or a runtime `1 << n` shift - which should be lowered to a shift/mask
+//     regardless of whether either operand is secret.
+//   - BRANCH: an if, a tagged switch, or a case expression in a tagless
+//     switch, whose condition transitively depends on a secret.
+//
+// Diagnostics can be suppressed per-line with a trailing "//ct:allow"
+// comment, mirroring the convention used by //nolint and //go:linkname.
+//
+// ctanalyzer is usable both as a go vet tool (via cmd/ctcheck) and as a
+// golang.org/x/tools/go/analysis.Analyzer embedded in a larger
+// multichecker.
+package ctanalyzer
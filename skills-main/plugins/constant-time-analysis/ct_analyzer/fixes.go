@@ -0,0 +1,101 @@
+package ctanalyzer
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/printer"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// suggestShiftFix rewrites `x / (1<<n)` to `x >> n` and `x % (1<<n)` to
+// `x & ((1<<n)-1)`, the two lowerings a constant-time decomposition should
+// use instead of a hardware divide. n may be a compile-time exponent or,
+// when pow came from a runtime `1 << n`, the shift expression itself.
+//
+// Both rewrites are only equivalent to Go's truncating `/`/`%` when the
+// dividend is non-negative: for signed x, x/(1<<n) rounds toward zero but
+// x>>n rounds toward -infinity, and x%(1<<n) can be negative while x&mask
+// never is (e.g. int32(-5)/4 == -1 but -5>>2 == -2). ML-DSA residues in
+// this codebase are frequently signed and can be negative, so refuse to
+// suggest a fix unless the dividend is statically provable non-negative;
+// the diagnostic itself still fires either way.
+func suggestShiftFix(pass *analysis.Pass, expr *ast.BinaryExpr, pow shiftAmount) (analysis.SuggestedFix, bool) {
+	if !isProvablyNonNegative(pass, expr.X) {
+		return analysis.SuggestedFix{}, false
+	}
+
+	dividend := exprString(pass, expr.X)
+	shiftBy := ""
+	if pow.isLiteral {
+		shiftBy = fmt.Sprintf("%d", pow.literal)
+	} else {
+		shiftBy = exprString(pass, pow.shiftBy)
+		if shiftBy == "" {
+			return analysis.SuggestedFix{}, false
+		}
+	}
+
+	if expr.Op.String() == "/" {
+		return analysis.SuggestedFix{
+			Message: fmt.Sprintf("replace with right shift by %s", shiftBy),
+			TextEdits: []analysis.TextEdit{{
+				Pos:     expr.Pos(),
+				End:     expr.End(),
+				NewText: []byte(fmt.Sprintf("%s >> %s", dividend, shiftBy)),
+			}},
+		}, true
+	}
+
+	if pow.isLiteral {
+		mask := (int64(1) << uint(pow.literal)) - 1
+		return analysis.SuggestedFix{
+			Message: fmt.Sprintf("replace with mask by 0x%x", mask),
+			TextEdits: []analysis.TextEdit{{
+				Pos:     expr.Pos(),
+				End:     expr.End(),
+				NewText: []byte(fmt.Sprintf("%s & 0x%x", dividend, mask)),
+			}},
+		}, true
+	}
+	return analysis.SuggestedFix{
+		Message: fmt.Sprintf("replace with mask by (1<<%s)-1", shiftBy),
+		TextEdits: []analysis.TextEdit{{
+			Pos:     expr.Pos(),
+			End:     expr.End(),
+			NewText: []byte(fmt.Sprintf("%s & ((1<<%s)-1)", dividend, shiftBy)),
+		}},
+	}, true
+}
+
+// exprString renders expr back to Go source using the pass's FileSet so
+// suggested fixes can splice a faithful copy of the original dividend.
+func exprString(pass *analysis.Pass, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, pass.Fset, expr); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// isProvablyNonNegative reports whether expr can never be negative: either
+// its type is an unsigned integer, or it's a non-negative integer constant.
+// Anything else - in particular a plain signed int/int32 local like the
+// ML-DSA residues this analyzer mostly sees - is assumed possibly negative.
+func isProvablyNonNegative(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok {
+		return false
+	}
+	if basic, ok := tv.Type.Underlying().(*types.Basic); ok && basic.Info()&types.IsUnsigned != 0 {
+		return true
+	}
+	if tv.Value != nil && tv.Value.Kind() == constant.Int {
+		v, exact := constant.Int64Val(tv.Value)
+		return exact && v >= 0
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package ctanalyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// allowComment suppresses a finding on the line it trails, e.g.:
+//
+//	r1 = r / twoGamma2 //ct:allow public decomposition bound, not secret
+const allowComment = "ct:allow"
+
+// suppressions maps a (file, line) pair to whether that line carries a
+// //ct:allow comment. It is rebuilt once per analysis run (see
+// newSuppressions) so isSuppressed is a cheap lookup during inspection.
+type suppressions map[lineKey]bool
+
+type lineKey struct {
+	file string
+	line int
+}
+
+func newSuppressions(fset *token.FileSet, files []*ast.File) suppressions {
+	s := make(suppressions)
+	for _, file := range files {
+		tf := fset.File(file.Pos())
+		if tf == nil {
+			continue
+		}
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				if !strings.Contains(c.Text, allowComment) {
+					continue
+				}
+				s[lineKey{tf.Name(), tf.Line(c.Pos())}] = true
+			}
+		}
+	}
+	return s
+}
+
+func (s suppressions) isSuppressed(fset *token.FileSet, n ast.Node) bool {
+	tf := fset.File(n.Pos())
+	if tf == nil {
+		return false
+	}
+	return s[lineKey{tf.Name(), tf.Line(n.Pos())}]
+}
@@ -0,0 +1,288 @@
+package ctanalyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// secretComment marks a declaration as carrying secret (timing-sensitive)
+// data, e.g.:
+//
+//	var priv int32 //ct:secret
+const secretComment = "ct:secret"
+
+// taintSet tracks which objects in a package are secret-tainted, and
+// propagates that taint across assignments, return values and call
+// arguments until a fixed point is reached.
+type taintSet struct {
+	pass    *analysis.Pass
+	objects map[types.Object]bool
+	// results[fn] reports, per return value, whether that position is
+	// ever returned tainted anywhere in fn's body.
+	results map[*types.Func][]bool
+}
+
+func newTaintSet(pass *analysis.Pass) *taintSet {
+	t := &taintSet{
+		pass:    pass,
+		objects: make(map[types.Object]bool),
+		results: make(map[*types.Func][]bool),
+	}
+	t.seedFromComments()
+	t.seedFromSecretType()
+	t.propagate()
+	return t
+}
+
+// seedFromComments marks every identifier declared alongside a //ct:secret
+// comment as tainted.
+func (t *taintSet) seedFromComments() {
+	for _, file := range t.pass.Files {
+		cmap := ast.NewCommentMap(t.pass.Fset, file, file.Comments)
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch decl := n.(type) {
+			case *ast.ValueSpec:
+				if hasSecretComment(cmap[decl]) {
+					for _, name := range decl.Names {
+						if obj := t.pass.TypesInfo.Defs[name]; obj != nil {
+							t.objects[obj] = true
+						}
+					}
+				}
+			case *ast.Field:
+				if hasSecretComment(cmap[decl]) {
+					for _, name := range decl.Names {
+						if obj := t.pass.TypesInfo.Defs[name]; obj != nil {
+							t.objects[obj] = true
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+func hasSecretComment(groups []*ast.CommentGroup) bool {
+	for _, g := range groups {
+		for _, c := range g.List {
+			if strings.Contains(c.Text, secretComment) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// seedFromSecretType marks every identifier whose static type is
+// (or embeds) ct.Secret[T] as tainted.
+func (t *taintSet) seedFromSecretType() {
+	for ident, obj := range t.pass.TypesInfo.Defs {
+		if obj == nil {
+			continue
+		}
+		if isSecretType(obj.Type()) {
+			t.objects[obj] = true
+			_ = ident
+		}
+	}
+}
+
+func isSecretType(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	if obj != nil && obj.Name() == "Secret" && obj.Pkg() != nil && strings.HasSuffix(obj.Pkg().Path(), "mldsa/ct") {
+		return true
+	}
+	if st, ok := named.Underlying().(*types.Struct); ok {
+		for i := 0; i < st.NumFields(); i++ {
+			if f := st.Field(i); f.Embedded() && isSecretType(f.Type()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// propagate runs a worklist fixpoint over assignments, return statements
+// and call arguments so that taint introduced in one function is visible
+// at its callers and callees within the package.
+func (t *taintSet) propagate() {
+	for {
+		changed := false
+		for _, file := range t.pass.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.AssignStmt:
+					if t.visitAssign(n) {
+						changed = true
+					}
+				case *ast.ReturnStmt:
+					if t.visitReturn(n) {
+						changed = true
+					}
+				case *ast.CallExpr:
+					if t.visitCall(n) {
+						changed = true
+					}
+				}
+				return true
+			})
+		}
+		if !changed {
+			return
+		}
+	}
+}
+
+func (t *taintSet) visitAssign(assign *ast.AssignStmt) bool {
+	changed := false
+	for i, rhs := range assign.Rhs {
+		if !t.isTainted(rhs) {
+			continue
+		}
+		if i >= len(assign.Lhs) {
+			continue
+		}
+		if ident, ok := assign.Lhs[i].(*ast.Ident); ok {
+			obj := t.pass.TypesInfo.ObjectOf(ident)
+			if obj != nil && !t.objects[obj] {
+				t.objects[obj] = true
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func (t *taintSet) visitReturn(ret *ast.ReturnStmt) bool {
+	fn := enclosingFunc(t.pass, ret)
+	if fn == nil {
+		return false
+	}
+	changed := false
+	slots := t.results[fn]
+	if slots == nil {
+		slots = make([]bool, len(ret.Results))
+		t.results[fn] = slots
+	}
+	for i, r := range ret.Results {
+		if i >= len(slots) {
+			break
+		}
+		if t.isTainted(r) && !slots[i] {
+			slots[i] = true
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (t *taintSet) visitCall(call *ast.CallExpr) bool {
+	fn, ok := t.pass.TypesInfo.Uses[identOf(call.Fun)].(*types.Func)
+	if !ok || fn == nil {
+		return false
+	}
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok {
+		return false
+	}
+	changed := false
+	for i, arg := range call.Args {
+		if i >= sig.Params().Len() || !t.isTainted(arg) {
+			continue
+		}
+		param := sig.Params().At(i)
+		if !t.objects[param] {
+			t.objects[param] = true
+			changed = true
+		}
+	}
+	return changed
+}
+
+func identOf(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.SelectorExpr:
+		return e.Sel
+	default:
+		return nil
+	}
+}
+
+func enclosingFunc(pass *analysis.Pass, n ast.Node) *types.Func {
+	for _, file := range pass.Files {
+		if file.Pos() > n.Pos() || file.End() < n.End() {
+			continue
+		}
+		var found *types.Func
+		ast.Inspect(file, func(m ast.Node) bool {
+			fd, ok := m.(*ast.FuncDecl)
+			if !ok || fd.Pos() > n.Pos() || fd.End() < n.End() {
+				return true
+			}
+			if obj, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func); ok {
+				found = obj
+			}
+			return true
+		})
+		if found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// isTainted reports whether expr transitively depends on a secret value.
+func (t *taintSet) isTainted(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		obj := t.pass.TypesInfo.ObjectOf(e)
+		return obj != nil && t.objects[obj]
+	case *ast.SelectorExpr:
+		if obj := t.pass.TypesInfo.ObjectOf(e.Sel); obj != nil && t.objects[obj] {
+			return true
+		}
+		return t.isTainted(e.X)
+	case *ast.BinaryExpr:
+		return t.isTainted(e.X) || t.isTainted(e.Y)
+	case *ast.UnaryExpr:
+		return t.isTainted(e.X)
+	case *ast.ParenExpr:
+		return t.isTainted(e.X)
+	case *ast.CallExpr:
+		if isSecretType(t.pass.TypesInfo.TypeOf(e)) {
+			return true
+		}
+		fn, ok := t.pass.TypesInfo.Uses[identOf(e.Fun)].(*types.Func)
+		if ok && fn != nil {
+			for _, tainted := range t.results[fn] {
+				if tainted {
+					return true
+				}
+			}
+		}
+		for _, arg := range e.Args {
+			if t.isTainted(arg) {
+				return true
+			}
+		}
+		return false
+	default:
+		if isSecretType(t.pass.TypesInfo.TypeOf(expr)) {
+			return true
+		}
+		return false
+	}
+}
@@ -0,0 +1,46 @@
+// Package decompose is the ctanalyzer testdata corpus. It mirrors
+// tests/test_samples/decompose_vulnerable.go, annotated with expected-
+// diagnostic comments for golang.org/x/tools/go/analysis/analysistest.
+package decompose
+
+const Q = 8380417
+
+func decomposeVulnerable(
+	r int32, //ct:secret
+	gamma2 int32,
+) (r1, r0 int32) {
+	twoGamma2 := 2 * gamma2
+
+	r1 = r / twoGamma2 // want `DIV: / on a secret-tainted dividend`
+	r0 = r % twoGamma2 // want `DIV: % on a secret-tainted dividend`
+
+	if r0 > gamma2 { // want `BRANCH: condition depends on a secret value`
+		r0 -= twoGamma2
+		r1 += 1
+	}
+
+	return r1, r0
+}
+
+func powerDecomposeVulnerable(r int32, d int32) (r1 int32, r0 int32) {
+	divisor := int32(1) << d
+	r1 = r / divisor // want `POW2DIV: division by constant power of two`
+	r0 = r % divisor // want `POW2DIV: division by constant power of two`
+	return r1, r0
+}
+
+func allowedDivision(pub, n int32) int32 {
+	return pub / n //ct:allow n is a public, compile-time-bounded constant
+}
+
+func taglessSwitchVulnerable(r int32, //ct:secret
+	gamma2 int32) int32 {
+	switch {
+	case r > gamma2: // want `BRANCH: condition depends on a secret value`
+		return 1
+	case r < -gamma2: // want `BRANCH: condition depends on a secret value`
+		return -1
+	default:
+		return 0
+	}
+}
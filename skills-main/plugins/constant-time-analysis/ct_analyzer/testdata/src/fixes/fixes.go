@@ -0,0 +1,21 @@
+// Package fixes is the ctanalyzer testdata corpus for POW2DIV's
+// SuggestedFixes, run through analysistest.RunWithSuggestedFixes against
+// fixes.go.golden. It isolates the one case where applying the fix is
+// unsafe (a signed, possibly-negative dividend) from the one where it
+// isn't (an unsigned dividend), since fixes.go and their golden must match
+// exactly where no fix should be offered.
+package fixes
+
+func signedPow2(r int32, d int32) (int32, int32) {
+	divisor := int32(1) << d
+	r1 := r / divisor // want `POW2DIV: division by constant power of two`
+	r0 := r % divisor // want `POW2DIV: division by constant power of two`
+	return r1, r0
+}
+
+func unsignedPow2(r uint32, d uint32) (uint32, uint32) {
+	divisor := uint32(1) << d
+	r1 := r / divisor // want `POW2DIV: division by constant power of two`
+	r0 := r % divisor // want `POW2DIV: division by constant power of two`
+	return r1, r0
+}
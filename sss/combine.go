@@ -0,0 +1,61 @@
+package sss
+
+import (
+	"errors"
+
+	"github.com/whetstoneresearch/doppler/mldsa/ct"
+)
+
+// Combine reconstructs the secret from shares via Lagrange interpolation
+// at x = 0, using mldsa/ct's constant-time MulMod/SubMod/Inverse for
+// every step so no arithmetic on share values branches or divides on
+// them. Any t of the shares a Split call with threshold t produced
+// reconstruct the original secret. Passing fewer than t, or shares from
+// different Split calls, is not detected as such: the result is either
+// wrong bytes or an error from unpacking a reconstructed chunk that is
+// out of range or carries a corrupt length prefix.
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("sss: no shares")
+	}
+
+	chunkCount := len(shares[0].Y)
+	seenX := make(map[int32]bool, len(shares))
+	for _, s := range shares {
+		if len(s.Y) != chunkCount {
+			return nil, errors.New("sss: shares have mismatched chunk counts")
+		}
+		if seenX[s.X] {
+			return nil, errors.New("sss: duplicate share evaluation point")
+		}
+		seenX[s.X] = true
+	}
+
+	chunks := make([]int32, chunkCount)
+	for ci := range chunks {
+		chunks[ci] = lagrangeAtZero(shares, ci)
+	}
+	return unpackChunks(chunks)
+}
+
+// lagrangeAtZero evaluates the degree-(len(shares)-1) polynomial that
+// interpolates (shares[i].X, shares[i].Y[chunkIndex]) for every i, at
+// x = 0 — which recovers the constant term Split used as the secret
+// chunk.
+func lagrangeAtZero(shares []Share, chunkIndex int) int32 {
+	acc := int32(0)
+	for i, si := range shares {
+		num := int32(1)
+		den := int32(1)
+		for j, sj := range shares {
+			if i == j {
+				continue
+			}
+			num = ct.MulMod(num, ct.SubMod(0, sj.X))
+			den = ct.MulMod(den, ct.SubMod(si.X, sj.X))
+		}
+		term := ct.MulMod(si.Y[chunkIndex], ct.MulMod(num, ct.Inverse(den)))
+		acc = ct.AddMod(acc, term)
+	}
+	return acc
+}
@@ -0,0 +1,8 @@
+// Package sss implements Shamir's (t, n) threshold secret sharing over
+// GF(Q), the ML-DSA prime field defined in mldsa/ct. It is intended for
+// splitting ML-DSA private key material across n custodians such that
+// any t of them can reconstruct it, while all arithmetic on the secret
+// reuses mldsa/ct's constant-time field operations so sharing key
+// material carries the same anti-KyberSlash guarantee as the signing and
+// verification paths.
+package sss
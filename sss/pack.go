@@ -0,0 +1,57 @@
+package sss
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// maxSecretLen bounds secrets to what fits in the 16-bit length prefix
+// packSecret writes ahead of the packed chunks.
+const maxSecretLen = 0xFFFF
+
+// packSecret splits secret into 16-bit big-endian field elements. Q is
+// just under 2^23, so every 16-bit chunk is guaranteed to land below Q
+// without any modular reduction, at the cost of leaving about 7 bits of
+// each field element unused. A 2-byte length prefix records the original
+// length so unpackChunks can trim the zero padding added to fill out the
+// final chunk.
+func packSecret(secret []byte) ([]int32, error) {
+	if len(secret) > maxSecretLen {
+		return nil, errors.New("sss: secret too large")
+	}
+
+	padded := make([]byte, 2+len(secret))
+	binary.BigEndian.PutUint16(padded[:2], uint16(len(secret)))
+	copy(padded[2:], secret)
+	if len(padded)%2 != 0 {
+		padded = append(padded, 0)
+	}
+
+	chunks := make([]int32, len(padded)/2)
+	for i := range chunks {
+		chunks[i] = int32(binary.BigEndian.Uint16(padded[2*i : 2*i+2]))
+	}
+	return chunks, nil
+}
+
+// unpackChunks reverses packSecret, recovering the original secret bytes
+// from reconstructed field elements.
+func unpackChunks(chunks []int32) ([]byte, error) {
+	if len(chunks) == 0 {
+		return nil, errors.New("sss: no data to unpack")
+	}
+
+	buf := make([]byte, 2*len(chunks))
+	for i, c := range chunks {
+		if c < 0 || c > 0xFFFF {
+			return nil, errors.New("sss: reconstructed chunk out of range")
+		}
+		binary.BigEndian.PutUint16(buf[2*i:2*i+2], uint16(c))
+	}
+
+	n := binary.BigEndian.Uint16(buf[:2])
+	if int(n)+2 > len(buf) {
+		return nil, errors.New("sss: corrupt length prefix")
+	}
+	return buf[2 : 2+int(n)], nil
+}
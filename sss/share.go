@@ -0,0 +1,10 @@
+package sss
+
+// Share is one participant's share of a secret split by Split. X is the
+// public evaluation point (1..n); it identifies the share but reveals
+// nothing about the secret on its own. Y holds one field element per
+// packed chunk of the secret, evaluated at X.
+type Share struct {
+	X int32
+	Y []int32
+}
@@ -0,0 +1,81 @@
+package sss
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+
+	"github.com/whetstoneresearch/doppler/mldsa/ct"
+)
+
+// Split divides secret into n shares such that any t of them reconstruct
+// it via Combine, while fewer than t reveal nothing about it.
+//
+// secret is packed into field elements below Q (see packSecret), and
+// each chunk becomes the constant term of an independent degree-(t-1)
+// polynomial whose remaining coefficients are sampled uniformly from
+// crypto/rand. Each share is that polynomial evaluated at x = 1..n via
+// Horner's method, using mldsa/ct's constant-time AddMod/MulMod so the
+// evaluation never branches or divides on the secret coefficients.
+func Split(secret []byte, t, n int) ([]Share, error) {
+	if t < 1 || n < 1 || t > n {
+		return nil, errors.New("sss: require 1 <= t <= n")
+	}
+	if n >= ct.Q {
+		return nil, errors.New("sss: n must be smaller than the field size")
+	}
+
+	chunks, err := packSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{X: int32(i + 1), Y: make([]int32, len(chunks))}
+	}
+
+	coeffs := make([]int32, t)
+	for ci, secretChunk := range chunks {
+		coeffs[0] = secretChunk
+		for k := 1; k < t; k++ {
+			c, err := randFieldElement()
+			if err != nil {
+				return nil, err
+			}
+			coeffs[k] = c
+		}
+
+		for i := range shares {
+			shares[i].Y[ci] = evalPoly(coeffs, shares[i].X)
+		}
+	}
+
+	return shares, nil
+}
+
+// evalPoly evaluates the polynomial with the given coefficients
+// (constant term first) at x, modulo Q, via Horner's method.
+func evalPoly(coeffs []int32, x int32) int32 {
+	acc := int32(0)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		acc = ct.AddMod(ct.MulMod(acc, x), coeffs[i])
+	}
+	return acc
+}
+
+// randFieldElement draws a uniformly random element of [0, Q) from
+// crypto/rand via rejection sampling: Q is just under 2^23, so a 23-bit
+// draw is rejected roughly 0.1% of the time.
+func randFieldElement() (int32, error) {
+	for {
+		var buf [4]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			return 0, err
+		}
+		v := binary.BigEndian.Uint32(buf[:]) & 0x7FFFFF
+		if v < ct.Q {
+			return int32(v), nil
+		}
+	}
+}
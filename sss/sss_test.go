@@ -0,0 +1,89 @@
+package sss_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/whetstoneresearch/doppler/sss"
+)
+
+func TestSplitCombineRoundTrip(t *testing.T) {
+	secrets := [][]byte{
+		[]byte("a secret ML-DSA signing key"),
+		[]byte(""),
+		[]byte{0x00},
+		bytes.Repeat([]byte{0xAB}, 257), // odd length, exercises chunk padding
+	}
+
+	for _, secret := range secrets {
+		shares, err := sss.Split(secret, 3, 5)
+		if err != nil {
+			t.Fatalf("Split(%q, 3, 5) error: %v", secret, err)
+		}
+		if len(shares) != 5 {
+			t.Fatalf("Split returned %d shares, want 5", len(shares))
+		}
+
+		got, err := sss.Combine(shares[:3])
+		if err != nil {
+			t.Fatalf("Combine error: %v", err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Fatalf("Combine(first 3 of 5 shares) = %q, want %q", got, secret)
+		}
+
+		// Any 3-of-5 subset should reconstruct the same secret.
+		got, err = sss.Combine([]sss.Share{shares[1], shares[3], shares[4]})
+		if err != nil {
+			t.Fatalf("Combine error: %v", err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Fatalf("Combine(shares 1,3,4) = %q, want %q", got, secret)
+		}
+	}
+}
+
+func TestSplitRejectsInvalidThreshold(t *testing.T) {
+	cases := []struct {
+		t, n int
+	}{
+		{0, 5},
+		{6, 5},
+		{-1, 5},
+	}
+	for _, c := range cases {
+		if _, err := sss.Split([]byte("secret"), c.t, c.n); err == nil {
+			t.Fatalf("Split(_, %d, %d) succeeded, want error", c.t, c.n)
+		}
+	}
+}
+
+func TestCombineRejectsDuplicateShares(t *testing.T) {
+	shares, err := sss.Split([]byte("secret"), 2, 3)
+	if err != nil {
+		t.Fatalf("Split error: %v", err)
+	}
+
+	if _, err := sss.Combine([]sss.Share{shares[0], shares[0]}); err == nil {
+		t.Fatal("Combine with a duplicated share succeeded, want error")
+	}
+}
+
+func TestSplitSharesAreIndependentOfEachOther(t *testing.T) {
+	// Below the threshold, two different secrets should not be
+	// distinguishable from a single share's value alone. This compares
+	// only the first chunk of the first share from each Split (the
+	// length prefix, equal for these two secrets), and a coincidence
+	// skips rather than fails; it is not a statistical proof.
+	sharesA, err := sss.Split([]byte("secret-one"), 3, 5)
+	if err != nil {
+		t.Fatalf("Split error: %v", err)
+	}
+	sharesB, err := sss.Split([]byte("secret-two"), 3, 5)
+	if err != nil {
+		t.Fatalf("Split error: %v", err)
+	}
+	if sharesA[0].Y[0] == sharesB[0].Y[0] {
+		t.Skip("extremely unlikely but not impossible coincidence; not a failure on its own")
+	}
+}